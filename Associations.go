@@ -1,10 +1,12 @@
 package hubspot
 
 import (
+	"context"
 	"fmt"
 	errortools "github.com/leapforce-libraries/go_errortools"
 	go_http "github.com/leapforce-libraries/go_http"
 	"net/http"
+	"time"
 )
 
 const maxBatchSize int = 10000
@@ -44,17 +46,78 @@ type BatchGetAssociationsConfig struct {
 	Ids            []string
 }
 
-func (service *Service) BatchGetAssociations(config *BatchGetAssociationsConfig) (*AssociationsV4Set, *errortools.Error) {
+// batchGetAssociationsResponse adds the per-input errors HubSpot includes in a
+// 207 Multi-Status response to the plain AssociationsV4Set shape.
+type batchGetAssociationsResponse struct {
+	AssociationsV4Set
+	Errors []struct {
+		Id       string `json:"id"`
+		Category string `json:"category"`
+		Message  string `json:"message"`
+		Status   string `json:"status"`
+	} `json:"errors"`
+}
+
+// BatchAssociationsError wraps the per-input failures of a 207 Multi-Status
+// response from the associations batch/read endpoint.
+type BatchAssociationsError struct {
+	Errors []BatchEngagementError
+}
+
+func (batchError *BatchAssociationsError) Error() string {
+	return fmt.Sprintf("%d input(s) failed in batch", len(batchError.Errors))
+}
+
+func newBatchAssociationsError(r batchGetAssociationsResponse) *BatchAssociationsError {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+
+	batchError := BatchAssociationsError{}
+
+	for _, responseError := range r.Errors {
+		batchError.Errors = append(batchError.Errors, BatchEngagementError{
+			Id:       responseError.Id,
+			Category: responseError.Category,
+			Message:  responseError.Message,
+			Status:   responseError.Status,
+		})
+	}
+
+	return &batchError
+}
+
+func (service *Service) BatchGetAssociations(config *BatchGetAssociationsConfig) (*AssociationsV4Set, *BatchAssociationsError, *errortools.Error) {
+	return service.BatchGetAssociationsContext(context.Background(), config)
+}
+
+// BatchGetAssociationsContext reads associations in chunks of maxBatchSize,
+// checking ctx between chunks so a large read can be cancelled or bounded by a
+// deadline. A 207 Multi-Status response does not abort the read; its per-input
+// failures are collected and returned as a BatchAssociationsError alongside the
+// associations that were read successfully.
+func (service *Service) BatchGetAssociationsContext(ctx context.Context, config *BatchGetAssociationsConfig) (*AssociationsV4Set, *BatchAssociationsError, *errortools.Error) {
 	if len(config.Ids) == 0 {
-		return nil, nil
+		return nil, nil, nil
 	}
 
+	ctx, cancel := service.contextWithDefaultTimeout(ctx)
+	defer cancel()
+
 	endpoint := fmt.Sprintf("associations/%v/%v/batch/read", config.FromObjectType, config.ToObjectType)
 
 	ids := config.Ids
 	var associationsV4Set AssociationsV4Set
+	var batchError BatchAssociationsError
 
 	for len(ids) > 0 {
+		if e := ctx.Err(); e != nil {
+			var partial *BatchAssociationsError
+			if len(batchError.Errors) > 0 {
+				partial = &batchError
+			}
+			return &associationsV4Set, partial, errortools.ErrorMessage(fmt.Sprintf("BatchGetAssociations cancelled: %s", e))
+		}
 
 		var body struct {
 			Inputs []struct {
@@ -72,21 +135,145 @@ func (service *Service) BatchGetAssociations(config *BatchGetAssociationsConfig)
 			body.Inputs = append(body.Inputs, idStruct)
 		}
 
-		var associationsV4Set_ AssociationsV4Set
+		var r batchGetAssociationsResponse
 
 		requestConfig := go_http.RequestConfig{
 			Method:        http.MethodPost,
 			Url:           service.urlV4(endpoint),
 			BodyModel:     body,
-			ResponseModel: &associationsV4Set_,
+			ResponseModel: &r,
+		}
+
+		_, response, e := service.httpRequestContext(ctx, &requestConfig)
+		if response == nil || response.StatusCode != http.StatusMultiStatus {
+			if e != nil {
+				return nil, nil, e
+			}
+		} else if chunkError := newBatchAssociationsError(r); chunkError != nil {
+			batchError.Errors = append(batchError.Errors, chunkError.Errors...)
 		}
 
-		_, _, e := service.httpRequest(&requestConfig)
-		if e != nil {
-			return nil, e
+		associationsV4Set.Results = append(associationsV4Set.Results, r.Results...)
+
+		if len(ids) > maxBatchSize {
+			ids = ids[maxBatchSize:]
+		} else {
+			break
+		}
+	}
+
+	if len(batchError.Errors) > 0 {
+		return &associationsV4Set, &batchError, nil
+	}
+
+	return &associationsV4Set, nil, nil
+}
+
+func (service *Service) BatchGetAssociationsWithRetry(config *BatchGetAssociationsConfig, maxAttempts int, initialBackoff time.Duration) (*AssociationsV4Set, *BatchAssociationsError, *errortools.Error) {
+	return service.BatchGetAssociationsWithRetryContext(context.Background(), config, maxAttempts, initialBackoff)
+}
+
+// BatchGetAssociationsWithRetryContext behaves like BatchGetAssociationsContext,
+// except that when a chunk's 207 response contains only retryable failures
+// (rate limiting or a transient server error), that chunk is resubmitted with
+// exponential backoff, up to maxAttempts times in total, before its failures
+// are surfaced. config.Ids already identify existing objects, so a retry is
+// narrowed by matching the rejected ids directly rather than by array index.
+func (service *Service) BatchGetAssociationsWithRetryContext(ctx context.Context, config *BatchGetAssociationsConfig, maxAttempts int, initialBackoff time.Duration) (*AssociationsV4Set, *BatchAssociationsError, *errortools.Error) {
+	if len(config.Ids) == 0 {
+		return nil, nil, nil
+	}
+
+	ctx, cancel := service.contextWithDefaultTimeout(ctx)
+	defer cancel()
+
+	endpoint := fmt.Sprintf("associations/%v/%v/batch/read", config.FromObjectType, config.ToObjectType)
+
+	ids := config.Ids
+	var associationsV4Set AssociationsV4Set
+	var batchError BatchAssociationsError
+
+	for len(ids) > 0 {
+		var chunkIds []string
+		if len(ids) > maxBatchSize {
+			chunkIds = ids[:maxBatchSize]
+		} else {
+			chunkIds = ids
 		}
+		backoff := initialBackoff
 
-		associationsV4Set.Results = append(associationsV4Set.Results, associationsV4Set_.Results...)
+		for attempt := 1; ; attempt++ {
+			if e := ctx.Err(); e != nil {
+				var partial *BatchAssociationsError
+				if len(batchError.Errors) > 0 {
+					partial = &batchError
+				}
+				return &associationsV4Set, partial, errortools.ErrorMessage(fmt.Sprintf("BatchGetAssociationsWithRetry cancelled: %s", e))
+			}
+
+			var body struct {
+				Inputs []struct {
+					Id string `json:"id"`
+				} `json:"inputs"`
+			}
+
+			for _, id := range chunkIds {
+				body.Inputs = append(body.Inputs, struct {
+					Id string `json:"id"`
+				}{id})
+			}
+
+			var r batchGetAssociationsResponse
+
+			requestConfig := go_http.RequestConfig{
+				Method:        http.MethodPost,
+				Url:           service.urlV4(endpoint),
+				BodyModel:     body,
+				ResponseModel: &r,
+			}
+
+			_, response, e := service.httpRequestContext(ctx, &requestConfig)
+			if response == nil || response.StatusCode != http.StatusMultiStatus {
+				if e != nil {
+					return nil, nil, e
+				}
+
+				associationsV4Set.Results = append(associationsV4Set.Results, r.Results...)
+				break
+			}
+
+			associationsV4Set.Results = append(associationsV4Set.Results, r.Results...)
+
+			chunkError := newBatchAssociationsError(r)
+			if chunkError == nil {
+				break
+			}
+
+			if attempt >= maxAttempts || !allAssociationErrorsRetryable(chunkError) {
+				batchError.Errors = append(batchError.Errors, chunkError.Errors...)
+				break
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, nil, errortools.ErrorMessage(fmt.Sprintf("BatchGetAssociationsWithRetry cancelled: %s", ctx.Err()))
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+
+			failedIds := make(map[string]bool, len(chunkError.Errors))
+			for _, associationError := range chunkError.Errors {
+				failedIds[associationError.Id] = true
+			}
+
+			retryIds := chunkIds[:0:0]
+			for _, id := range chunkIds {
+				if failedIds[id] {
+					retryIds = append(retryIds, id)
+				}
+			}
+			chunkIds = retryIds
+		}
 
 		if len(ids) > maxBatchSize {
 			ids = ids[maxBatchSize:]
@@ -95,5 +282,25 @@ func (service *Service) BatchGetAssociations(config *BatchGetAssociationsConfig)
 		}
 	}
 
-	return &associationsV4Set, nil
+	if len(batchError.Errors) > 0 {
+		return &associationsV4Set, &batchError, nil
+	}
+
+	return &associationsV4Set, nil, nil
+}
+
+// allAssociationErrorsRetryable reports whether every failure in batchError is
+// retryable, mirroring allErrorsRetryable for the associations batch/read shape.
+func allAssociationErrorsRetryable(batchError *BatchAssociationsError) bool {
+	if batchError == nil || len(batchError.Errors) == 0 {
+		return false
+	}
+
+	for _, associationError := range batchError.Errors {
+		if !isRetryableBatchCategory(associationError.Category) {
+			return false
+		}
+	}
+
+	return true
 }
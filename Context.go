@@ -0,0 +1,113 @@
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+	go_http "github.com/leapforce-libraries/go_http"
+)
+
+// WithDefaultTimeout configures a deadline that is applied to each call of a
+// *Context method of Service which is called with a ctx that carries no deadline
+// of its own (e.g. context.Background()). The deadline bounds the whole call, so
+// for a paginated or chunked method it covers all of its requests together. It
+// has no effect on a ctx that already has a deadline or on a ctx derived from one.
+func (service *Service) WithDefaultTimeout(d time.Duration) *Service {
+	service.defaultTimeout = d
+
+	return service
+}
+
+// contextWithDefaultTimeout derives a context bounded by Service's default
+// timeout from ctx. If no default timeout is set, or ctx already carries a
+// deadline, it returns ctx itself together with a no-op CancelFunc.
+func (service *Service) contextWithDefaultTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if service.defaultTimeout <= 0 {
+		return ctx, func() {}
+	}
+
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, service.defaultTimeout)
+}
+
+// maxRateLimitRetries bounds how many times httpRequestContext will retry a
+// single request after a 429 or 5xx response before giving up.
+const maxRateLimitRetries = 5
+
+// httpRequestContext acquires service's RateLimiter (if any), then runs an
+// httpRequest call, retrying on a 429 or 5xx response per retryAfter up to
+// maxRateLimitRetries times. Each attempt races against ctx on a separate
+// goroutine, so a single cancellation source (ctx.Done()) can interrupt a
+// sequence of otherwise blocking httpRequest calls, e.g. between pages of a
+// paginated scan or between chunks of a batch operation.
+func (service *Service) httpRequestContext(ctx context.Context, requestConfig *go_http.RequestConfig) (*http.Request, *http.Response, *errortools.Error) {
+	for attempt := 0; ; attempt++ {
+		if rateLimiter := service.rateLimiter; rateLimiter != nil {
+			if e := rateLimiter.Acquire(ctx); e != nil {
+				return nil, nil, e
+			}
+		}
+
+		request, response, e := service.httpRequestOnce(ctx, requestConfig)
+
+		if rateLimiter := service.rateLimiter; rateLimiter != nil {
+			rateLimiter.Release()
+		}
+
+		if attempt >= maxRateLimitRetries || !isRetryableResponse(response) {
+			return request, response, e
+		}
+
+		if e := sleepContext(ctx, retryAfter(response)); e != nil {
+			return nil, nil, e
+		}
+	}
+}
+
+// httpRequestOnce runs a single httpRequest call on a separate goroutine and
+// races it against ctx.
+func (service *Service) httpRequestOnce(ctx context.Context, requestConfig *go_http.RequestConfig) (*http.Request, *http.Response, *errortools.Error) {
+	if e := ctx.Err(); e != nil {
+		return nil, nil, errortools.ErrorMessage(fmt.Sprintf("request not sent, context already done: %s", e))
+	}
+
+	type httpResult struct {
+		request  *http.Request
+		response *http.Response
+		e        *errortools.Error
+	}
+
+	resultChannel := make(chan httpResult, 1)
+
+	go func() {
+		request, response, e := service.httpRequest(requestConfig)
+		resultChannel <- httpResult{request, response, e}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, nil, errortools.ErrorMessage(fmt.Sprintf("request cancelled: %s", ctx.Err()))
+	case r := <-resultChannel:
+		return r.request, r.response, r.e
+	}
+}
+
+// sleepContext blocks for d, returning early with an errortools.Error if ctx is
+// cancelled or its deadline expires first.
+func sleepContext(ctx context.Context, d time.Duration) *errortools.Error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return errortools.ErrorMessage(fmt.Sprintf("cancelled while waiting to retry: %s", ctx.Err()))
+	case <-timer.C:
+		return nil
+	}
+}
@@ -1,10 +1,12 @@
 package hubspot
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -51,11 +53,58 @@ type ListEngagementsConfig struct {
 
 // ListEngagements returns all engagements
 func (service *Service) ListEngagements(config *ListEngagementsConfig) (*[]Engagement, *errortools.Error) {
+	return service.ListEngagementsContext(context.Background(), config)
+}
+
+// ListEngagementsContext returns all engagements, aborting between page fetches
+// as soon as ctx is cancelled or its deadline expires. It is implemented on top
+// of IterateEngagements so the two never drift in pagination behaviour.
+func (service *Service) ListEngagementsContext(ctx context.Context, config *ListEngagementsConfig) (*[]Engagement, *errortools.Error) {
+	ctx, cancel := service.contextWithDefaultTimeout(ctx)
+	defer cancel()
+
+	it := service.IterateEngagements(ctx, config)
+
+	var engagements []Engagement
+
+	for {
+		engagement, ok, e := it.Next(ctx)
+		if e != nil {
+			return nil, e
+		}
+		if !ok {
+			break
+		}
+
+		engagements = append(engagements, *engagement)
+	}
+
+	return &engagements, nil
+}
+
+// ListEngagementsPages returns a single page of engagements together with its
+// Paging, so a caller can persist Paging.Next.After and resume the scan across
+// process restarts instead of holding an EngagementIterator in memory.
+func (service *Service) ListEngagementsPages(config *ListEngagementsConfig) (*[]Engagement, *Paging, *errortools.Error) {
+	return service.ListEngagementsPagesContext(context.Background(), config)
+}
+
+// ListEngagementsPagesContext is the ctx-aware variant of ListEngagementsPages.
+func (service *Service) ListEngagementsPagesContext(ctx context.Context, config *ListEngagementsConfig) (*[]Engagement, *Paging, *errortools.Error) {
+	ctx, cancel := service.contextWithDefaultTimeout(ctx)
+	defer cancel()
+
+	return service.listEngagementsPage(ctx, config)
+}
+
+// listEngagementsPage fetches exactly one page of engagements for config,
+// honouring config.After as the cursor to resume from. It is the single place
+// that knows how to build a ListEngagements request; ListEngagementsContext and
+// EngagementIterator are both built on top of it.
+func (service *Service) listEngagementsPage(ctx context.Context, config *ListEngagementsConfig) (*[]Engagement, *Paging, *errortools.Error) {
 	values := url.Values{}
 	endpoint := fmt.Sprintf("objects/%v", config.Type)
 
-	after := ""
-
 	if config != nil {
 		if config.Limit != nil {
 			values.Set("limit", fmt.Sprintf("%v", *config.Limit))
@@ -83,50 +132,58 @@ func (service *Service) ListEngagements(config *ListEngagementsConfig) (*[]Engag
 		}
 
 		if config.After != nil {
-			after = *config.After
+			values.Set("after", *config.After)
 		}
 	}
 
-	var engagements []Engagement
+	engagementsResponse := EngagementsResponse{}
 
-	for {
-		engagementsResponse := EngagementsResponse{}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.urlCrm(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
+		ResponseModel: &engagementsResponse,
+	}
 
-		if after != "" {
-			values.Set("after", after)
-		}
+	_, _, e := service.httpRequestContext(ctx, &requestConfig)
+	if e != nil {
+		return nil, nil, e
+	}
 
-		requestConfig := go_http.RequestConfig{
-			Method:        http.MethodGet,
-			Url:           service.urlCrm(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
-			ResponseModel: &engagementsResponse,
-		}
+	return &engagementsResponse.Results, engagementsResponse.Paging, nil
+}
 
-		_, _, e := service.httpRequest(&requestConfig)
-		if e != nil {
-			return nil, e
-		}
+// IterateEngagements returns an EngagementIterator that lazily fetches pages of
+// engagements via Next, only hitting the API again once its buffered page is
+// exhausted. If config.After is set, iteration is limited to that single page,
+// matching the explicit-after behaviour of ListEngagements.
+func (service *Service) IterateEngagements(ctx context.Context, config *ListEngagementsConfig) *EngagementIterator {
+	explicitAfter := config != nil && config.After != nil
+	fetched := false
 
-		engagements = append(engagements, engagementsResponse.Results...)
+	pageConfig := ListEngagementsConfig{}
+	if config != nil {
+		pageConfig = *config
+	}
 
-		if config != nil {
-			if config.After != nil { // explicit after parameter requested
-				break
+	return &EngagementIterator{
+		nextPage: func(ctx context.Context) (*[]Engagement, *Paging, *errortools.Error) {
+			if explicitAfter && fetched {
+				return nil, nil, nil
 			}
-		}
+			fetched = true
 
-		if engagementsResponse.Paging == nil {
-			break
-		}
+			engagements, paging, e := service.listEngagementsPage(ctx, &pageConfig)
+			if e != nil {
+				return nil, nil, e
+			}
 
-		if engagementsResponse.Paging.Next.After == "" {
-			break
-		}
+			if !explicitAfter && paging != nil {
+				pageConfig.After = &paging.Next.After
+			}
 
-		after = engagementsResponse.Paging.Next.After
+			return engagements, paging, nil
+		},
 	}
-
-	return &engagements, nil
 }
 
 type CreateEngagementConfig struct {
@@ -193,29 +250,56 @@ func (service *Service) UpdateEngagement(config *UpdateEngagementConfig) (*Engag
 	return &engagement, nil
 }
 
-func (service *Service) BatchArchiveEngagements(engagementType EngagementType, engagementIds []string) *errortools.Error {
+func (service *Service) BatchArchiveEngagements(engagementType EngagementType, engagementIds []string) (*BatchEngagementsError, *errortools.Error) {
+	return service.BatchArchiveEngagementsContext(context.Background(), engagementType, engagementIds)
+}
+
+// BatchArchiveEngagementsContext archives engagementIds in chunks of 100,
+// checking ctx between chunks so a long archive run can be aborted promptly. A
+// 207 Multi-Status response does not abort the run; its per-input failures are
+// collected and returned as a BatchEngagementsError once all chunks are done.
+func (service *Service) BatchArchiveEngagementsContext(ctx context.Context, engagementType EngagementType, engagementIds []string) (*BatchEngagementsError, *errortools.Error) {
+	ctx, cancel := service.contextWithDefaultTimeout(ctx)
+	defer cancel()
+
+	var batchError BatchEngagementsError
+
 	var maxItemsPerBatch = 100
 	var index = 0
 	for len(engagementIds) > index {
-		if len(engagementIds) > index+maxItemsPerBatch {
-			e := service.batchArchiveEngagements(engagementType, engagementIds[index:index+maxItemsPerBatch])
-			if e != nil {
-				return e
+		if e := ctx.Err(); e != nil {
+			var partial *BatchEngagementsError
+			if len(batchError.Errors) > 0 {
+				partial = &batchError
 			}
+			return partial, errortools.ErrorMessage(fmt.Sprintf("BatchArchiveEngagements cancelled: %s", e))
+		}
+
+		var chunkError *BatchEngagementsError
+		var e *errortools.Error
+		if len(engagementIds) > index+maxItemsPerBatch {
+			chunkError, e = service.batchArchiveEngagements(ctx, engagementType, engagementIds[index:index+maxItemsPerBatch])
 		} else {
-			e := service.batchArchiveEngagements(engagementType, engagementIds[index:])
-			if e != nil {
-				return e
-			}
+			chunkError, e = service.batchArchiveEngagements(ctx, engagementType, engagementIds[index:])
+		}
+		if e != nil {
+			return nil, e
+		}
+		if chunkError != nil {
+			batchError.Errors = append(batchError.Errors, chunkError.Errors...)
 		}
 
 		index += maxItemsPerBatch
 	}
 
-	return nil
+	if len(batchError.Errors) > 0 {
+		return &batchError, nil
+	}
+
+	return nil, nil
 }
 
-func (service *Service) batchArchiveEngagements(engagementType EngagementType, engagementIds []string) *errortools.Error {
+func (service *Service) batchArchiveEngagements(ctx context.Context, engagementType EngagementType, engagementIds []string) (*BatchEngagementsError, *errortools.Error) {
 	var body struct {
 		Inputs []struct {
 			Id string `json:"id"`
@@ -228,22 +312,166 @@ func (service *Service) batchArchiveEngagements(engagementType EngagementType, e
 		}{engagementId})
 	}
 
+	var r BatchEngagementsResponse
+
 	requestConfig := go_http.RequestConfig{
-		Method:    http.MethodPost,
-		Url:       service.urlCrm(fmt.Sprintf("objects/%v/batch/archive", engagementType)),
-		BodyModel: body,
+		Method:        http.MethodPost,
+		Url:           service.urlCrm(fmt.Sprintf("objects/%v/batch/archive", engagementType)),
+		BodyModel:     body,
+		ResponseModel: &r,
 	}
 
-	_, _, e := service.httpRequest(&requestConfig)
-	return e
+	_, response, e := service.httpRequestContext(ctx, &requestConfig)
+	if response != nil && response.StatusCode == http.StatusMultiStatus {
+		return newBatchEngagementsError(r), nil
+	}
+	if e != nil {
+		return nil, e
+	}
+
+	return nil, nil
+}
+
+// BatchArchiveEngagementsWithRetry behaves like BatchArchiveEngagements, except
+// that when a chunk's 207 response contains only retryable failures (rate
+// limiting or a transient server error), that chunk is resubmitted with
+// exponential backoff, up to maxAttempts times in total, before its failures
+// are surfaced. Unlike a batch/create, the engagementIds being archived already
+// identify existing engagements, so a retry is narrowed by matching the
+// rejected ids directly rather than by array index.
+func (service *Service) BatchArchiveEngagementsWithRetry(engagementType EngagementType, engagementIds []string, maxAttempts int, initialBackoff time.Duration) (*BatchEngagementsError, *errortools.Error) {
+	return service.BatchArchiveEngagementsWithRetryContext(context.Background(), engagementType, engagementIds, maxAttempts, initialBackoff)
+}
+
+// BatchArchiveEngagementsWithRetryContext is the ctx-aware variant of
+// BatchArchiveEngagementsWithRetry.
+func (service *Service) BatchArchiveEngagementsWithRetryContext(ctx context.Context, engagementType EngagementType, engagementIds []string, maxAttempts int, initialBackoff time.Duration) (*BatchEngagementsError, *errortools.Error) {
+	ctx, cancel := service.contextWithDefaultTimeout(ctx)
+	defer cancel()
+
+	var batchError BatchEngagementsError
+
+	var maxItemsPerBatch = 100
+	var index = 0
+	for len(engagementIds) > index {
+		var chunkIds []string
+		if len(engagementIds) > index+maxItemsPerBatch {
+			chunkIds = engagementIds[index : index+maxItemsPerBatch]
+		} else {
+			chunkIds = engagementIds[index:]
+		}
+		backoff := initialBackoff
+
+		for attempt := 1; ; attempt++ {
+			if e := ctx.Err(); e != nil {
+				var partial *BatchEngagementsError
+				if len(batchError.Errors) > 0 {
+					partial = &batchError
+				}
+				return partial, errortools.ErrorMessage(fmt.Sprintf("BatchArchiveEngagementsWithRetry cancelled: %s", e))
+			}
+
+			chunkError, e := service.batchArchiveEngagements(ctx, engagementType, chunkIds)
+			if e != nil {
+				return nil, e
+			}
+			if chunkError == nil {
+				break
+			}
+
+			if attempt >= maxAttempts || !allErrorsRetryable(chunkError) {
+				batchError.Errors = append(batchError.Errors, chunkError.Errors...)
+				break
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, errortools.ErrorMessage(fmt.Sprintf("BatchArchiveEngagementsWithRetry cancelled: %s", ctx.Err()))
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+
+			failedIds := make(map[string]bool, len(chunkError.Errors))
+			for _, engagementError := range chunkError.Errors {
+				failedIds[engagementError.Id] = true
+			}
+
+			retryIds := chunkIds[:0:0]
+			for _, id := range chunkIds {
+				if failedIds[id] {
+					retryIds = append(retryIds, id)
+				}
+			}
+			chunkIds = retryIds
+		}
+
+		index += maxItemsPerBatch
+	}
+
+	if len(batchError.Errors) > 0 {
+		return &batchError, nil
+	}
+
+	return nil, nil
 }
 
 // SearchEngagements returns a specific engagement
 func (service *Service) SearchEngagements(objectType ObjectType, config *SearchObjectsConfig) (*[]Engagement, *errortools.Error) {
+	return service.SearchEngagementsContext(context.Background(), objectType, config)
+}
+
+// SearchEngagementsContext returns all matching engagements, checking ctx between
+// page fetches so a long-running search can be cancelled or bounded by a deadline.
+// It is implemented on top of IterateSearchEngagements.
+func (service *Service) SearchEngagementsContext(ctx context.Context, objectType ObjectType, config *SearchObjectsConfig) (*[]Engagement, *errortools.Error) {
 	if config == nil {
 		return nil, errortools.ErrorMessage("Config is nil")
 	}
 
+	ctx, cancel := service.contextWithDefaultTimeout(ctx)
+	defer cancel()
+
+	it := service.IterateSearchEngagements(ctx, objectType, config)
+
+	var engagements []Engagement
+
+	for {
+		engagement, ok, e := it.Next(ctx)
+		if e != nil {
+			return nil, e
+		}
+		if !ok {
+			break
+		}
+
+		engagements = append(engagements, *engagement)
+	}
+
+	return &engagements, nil
+}
+
+// SearchEngagementsPages returns a single page of search results together with
+// its Paging, so a caller can persist Paging.Next.After and resume the search
+// across process restarts.
+func (service *Service) SearchEngagementsPages(objectType ObjectType, config *SearchObjectsConfig) (*[]Engagement, *Paging, *errortools.Error) {
+	return service.SearchEngagementsPagesContext(context.Background(), objectType, config)
+}
+
+// SearchEngagementsPagesContext is the ctx-aware variant of SearchEngagementsPages.
+func (service *Service) SearchEngagementsPagesContext(ctx context.Context, objectType ObjectType, config *SearchObjectsConfig) (*[]Engagement, *Paging, *errortools.Error) {
+	if config == nil {
+		return nil, nil, errortools.ErrorMessage("Config is nil")
+	}
+
+	ctx, cancel := service.contextWithDefaultTimeout(ctx)
+	defer cancel()
+
+	return service.searchEngagementsPage(ctx, objectType, config)
+}
+
+// searchEngagementsPage fetches exactly one page of search results for config,
+// honouring config.After as the cursor to resume from.
+func (service *Service) searchEngagementsPage(ctx context.Context, objectType ObjectType, config *SearchObjectsConfig) (*[]Engagement, *Paging, *errortools.Error) {
 	endpoint := fmt.Sprintf("objects/%s/search", objectType)
 
 	engagementsResponse := EngagementsResponse{}
@@ -255,48 +483,46 @@ func (service *Service) SearchEngagements(objectType ObjectType, config *SearchO
 		ResponseModel: &engagementsResponse,
 	}
 
-	_, _, e := service.httpRequest(&requestConfig)
+	_, _, e := service.httpRequestContext(ctx, &requestConfig)
 	if e != nil {
-		return nil, e
+		return nil, nil, e
 	}
 
-	after := config.After
-
-	var engagements []Engagement
-
-	for {
-		engagementsResponse := EngagementsResponse{}
-
-		requestConfig := go_http.RequestConfig{
-			Method:        http.MethodPost,
-			Url:           service.urlCrm(endpoint),
-			BodyModel:     config,
-			ResponseModel: &engagementsResponse,
-		}
+	return &engagementsResponse.Results, engagementsResponse.Paging, nil
+}
 
-		_, _, e := service.httpRequest(&requestConfig)
-		if e != nil {
-			return nil, e
-		}
+// IterateSearchEngagements returns an EngagementIterator that lazily fetches
+// pages of search results via Next, only hitting the API again once its
+// buffered page is exhausted. If config.After is set, iteration is limited to
+// that single page, matching the explicit-after behaviour of SearchEngagements.
+func (service *Service) IterateSearchEngagements(ctx context.Context, objectType ObjectType, config *SearchObjectsConfig) *EngagementIterator {
+	explicitAfter := config != nil && config.After != nil
+	fetched := false
 
-		engagements = append(engagements, engagementsResponse.Results...)
+	pageConfig := SearchObjectsConfig{}
+	if config != nil {
+		pageConfig = *config
+	}
 
-		if after != nil { // explicit after parameter requested
-			break
-		}
+	return &EngagementIterator{
+		nextPage: func(ctx context.Context) (*[]Engagement, *Paging, *errortools.Error) {
+			if explicitAfter && fetched {
+				return nil, nil, nil
+			}
+			fetched = true
 
-		if engagementsResponse.Paging == nil {
-			break
-		}
+			engagements, paging, e := service.searchEngagementsPage(ctx, objectType, &pageConfig)
+			if e != nil {
+				return nil, nil, e
+			}
 
-		if engagementsResponse.Paging.Next.After == "" {
-			break
-		}
+			if !explicitAfter && paging != nil {
+				pageConfig.After = &paging.Next.After
+			}
 
-		config.After = &engagementsResponse.Paging.Next.After
+			return engagements, paging, nil
+		},
 	}
-
-	return &engagements, nil
 }
 
 type BatchEngagementsResponse struct {
@@ -327,10 +553,104 @@ type BatchEngagementsResponse struct {
 	Status string `json:"status"`
 }
 
-func (service *Service) BatchCreateEngagements(config *BatchObjectsConfig) (*[]Engagement, *errortools.Error) {
+// BatchEngagementError describes a single input that HubSpot rejected within a
+// 207 Multi-Status response from a batch engagements endpoint.
+type BatchEngagementError struct {
+	Id            string
+	Category      string
+	SubCategory   json.RawMessage
+	Message       string
+	MissingScopes []string
+	Status        string
+}
+
+// BatchEngagementsError wraps the per-input failures of a 207 Multi-Status
+// batch response, so callers can inspect, log or retry them individually
+// instead of having them swallowed.
+type BatchEngagementsError struct {
+	Errors []BatchEngagementError
+}
+
+func (batchError *BatchEngagementsError) Error() string {
+	return fmt.Sprintf("%d input(s) failed in batch", len(batchError.Errors))
+}
+
+func newBatchEngagementsError(r BatchEngagementsResponse) *BatchEngagementsError {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+
+	batchError := BatchEngagementsError{}
+
+	for _, responseError := range r.Errors {
+		var missingScopes []string
+		for _, inner := range responseError.Errors {
+			missingScopes = append(missingScopes, inner.Context.MissingScopes...)
+		}
+
+		batchError.Errors = append(batchError.Errors, BatchEngagementError{
+			Id:            responseError.Id,
+			Category:      responseError.Category,
+			SubCategory:   responseError.SubCategory,
+			Message:       responseError.Message,
+			MissingScopes: missingScopes,
+			Status:        responseError.Status,
+		})
+	}
+
+	return &batchError
+}
+
+// isRetryableBatchCategory reports whether category is one HubSpot uses for
+// rate limiting or a transient server error, i.e. one worth retrying.
+func isRetryableBatchCategory(category string) bool {
+	if category == "RATE_LIMITS" {
+		return true
+	}
+
+	return strings.Contains(category, "SERVER_ERROR")
+}
+
+// allErrorsRetryable reports whether every failure in batchError is retryable.
+func allErrorsRetryable(batchError *BatchEngagementsError) bool {
+	if batchError == nil || len(batchError.Errors) == 0 {
+		return false
+	}
+
+	for _, engagementError := range batchError.Errors {
+		if !isRetryableBatchCategory(engagementError.Category) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (service *Service) BatchCreateEngagements(config *BatchObjectsConfig) (*[]Engagement, *BatchEngagementsError, *errortools.Error) {
+	return service.BatchCreateEngagementsContext(context.Background(), config)
+}
+
+// BatchCreateEngagementsContext creates engagements in chunks, checking ctx
+// between chunks so a large batch create can be cancelled or bounded by a
+// deadline. A 207 Multi-Status response does not abort the batch; its
+// per-input failures are collected and returned as a BatchEngagementsError
+// alongside the engagements that did succeed.
+func (service *Service) BatchCreateEngagementsContext(ctx context.Context, config *BatchObjectsConfig) (*[]Engagement, *BatchEngagementsError, *errortools.Error) {
+	ctx, cancel := service.contextWithDefaultTimeout(ctx)
+	defer cancel()
+
 	var engagements []Engagement
+	var batchError BatchEngagementsError
 
 	for _, batch := range service.batches(len(config.Inputs)) {
+		if e := ctx.Err(); e != nil {
+			var partial *BatchEngagementsError
+			if len(batchError.Errors) > 0 {
+				partial = &batchError
+			}
+			return &engagements, partial, errortools.ErrorMessage(fmt.Sprintf("BatchCreateEngagements cancelled: %s", e))
+		}
+
 		var r BatchEngagementsResponse
 
 		requestConfig := go_http.RequestConfig{
@@ -340,29 +660,148 @@ func (service *Service) BatchCreateEngagements(config *BatchObjectsConfig) (*[]E
 			ResponseModel: &r,
 		}
 
-		_, response, e := service.httpRequest(&requestConfig)
-		if response != nil {
-			if response.StatusCode == http.StatusMultiStatus {
-				fmt.Println(r.Errors)
-				goto ok
+		_, response, e := service.httpRequestContext(ctx, &requestConfig)
+		if response == nil || response.StatusCode != http.StatusMultiStatus {
+			if e != nil {
+				return nil, nil, e
 			}
+		} else if chunkError := newBatchEngagementsError(r); chunkError != nil {
+			batchError.Errors = append(batchError.Errors, chunkError.Errors...)
 		}
-		if e != nil {
-			return nil, e
-		}
-	ok:
+
 		engagements = append(engagements, r.Results...)
+	}
 
-		fmt.Println("batch", batch.startIndex)
+	if len(batchError.Errors) > 0 {
+		return &engagements, &batchError, nil
 	}
 
-	return &engagements, nil
+	return &engagements, nil, nil
+}
+
+// BatchCreateEngagementsWithRetry behaves like BatchCreateEngagements, except
+// that when a chunk's 207 response contains only retryable failures (rate
+// limiting or a transient server error), that chunk is resubmitted with
+// exponential backoff, up to maxAttempts times in total, before its failures
+// are surfaced.
+func (service *Service) BatchCreateEngagementsWithRetry(config *BatchObjectsConfig, maxAttempts int, initialBackoff time.Duration) (*[]Engagement, *BatchEngagementsError, *errortools.Error) {
+	return service.BatchCreateEngagementsWithRetryContext(context.Background(), config, maxAttempts, initialBackoff)
+}
+
+// BatchCreateEngagementsWithRetryContext is the ctx-aware variant of
+// BatchCreateEngagementsWithRetry.
+func (service *Service) BatchCreateEngagementsWithRetryContext(ctx context.Context, config *BatchObjectsConfig, maxAttempts int, initialBackoff time.Duration) (*[]Engagement, *BatchEngagementsError, *errortools.Error) {
+	ctx, cancel := service.contextWithDefaultTimeout(ctx)
+	defer cancel()
+
+	var engagements []Engagement
+	var batchError BatchEngagementsError
+
+	for _, batch := range service.batches(len(config.Inputs)) {
+		chunkConfig := BatchObjectsConfig{Inputs: config.Inputs[batch.startIndex:batch.endIndex]}
+		backoff := initialBackoff
+
+		for attempt := 1; ; attempt++ {
+			if e := ctx.Err(); e != nil {
+				var partial *BatchEngagementsError
+				if len(batchError.Errors) > 0 {
+					partial = &batchError
+				}
+				return &engagements, partial, errortools.ErrorMessage(fmt.Sprintf("BatchCreateEngagementsWithRetry cancelled: %s", e))
+			}
+
+			var r BatchEngagementsResponse
+
+			requestConfig := go_http.RequestConfig{
+				Method:        http.MethodPost,
+				Url:           service.urlCrm(fmt.Sprintf("objects/%s/batch/create", config.ObjectType)),
+				BodyModel:     chunkConfig,
+				ResponseModel: &r,
+			}
+
+			_, response, e := service.httpRequestContext(ctx, &requestConfig)
+			if response == nil || response.StatusCode != http.StatusMultiStatus {
+				if e != nil {
+					return nil, nil, e
+				}
+
+				engagements = append(engagements, r.Results...)
+				break
+			}
+
+			engagements = append(engagements, r.Results...)
+
+			chunkError := newBatchEngagementsError(r)
+			if chunkError == nil {
+				break
+			}
+
+			if attempt >= maxAttempts || !allErrorsRetryable(chunkError) {
+				batchError.Errors = append(batchError.Errors, chunkError.Errors...)
+				break
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, nil, errortools.ErrorMessage(fmt.Sprintf("BatchCreateEngagementsWithRetry cancelled: %s", ctx.Err()))
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+
+			// Narrow the next attempt down to just the inputs HubSpot rejected,
+			// so the inputs it already accepted are not resubmitted (and thus
+			// not recreated as duplicates). Inputs to a batch/create have no id
+			// of their own yet, so HubSpot reports failures by the input's index
+			// within the submitted array rather than by id.
+			failedIndices := make(map[int]bool, len(chunkError.Errors))
+			for _, engagementError := range chunkError.Errors {
+				if index, err := strconv.Atoi(engagementError.Id); err == nil {
+					failedIndices[index] = true
+				}
+			}
+
+			retryInputs := chunkConfig.Inputs[:0:0]
+			for index, input := range chunkConfig.Inputs {
+				if failedIndices[index] {
+					retryInputs = append(retryInputs, input)
+				}
+			}
+			chunkConfig.Inputs = retryInputs
+		}
+	}
+
+	if len(batchError.Errors) > 0 {
+		return &engagements, &batchError, nil
+	}
+
+	return &engagements, nil, nil
+}
+
+func (service *Service) BatchUpdateEngagements(config *BatchObjectsConfig) (*[]Engagement, *BatchEngagementsError, *errortools.Error) {
+	return service.BatchUpdateEngagementsContext(context.Background(), config)
 }
 
-func (service *Service) BatchUpdateEngagements(config *BatchObjectsConfig) (*[]Engagement, *errortools.Error) {
+// BatchUpdateEngagementsContext updates engagements in chunks, checking ctx
+// between chunks so a large batch update can be cancelled or bounded by a
+// deadline. A 207 Multi-Status response does not abort the batch; its
+// per-input failures are collected and returned as a BatchEngagementsError
+// alongside the engagements that did succeed.
+func (service *Service) BatchUpdateEngagementsContext(ctx context.Context, config *BatchObjectsConfig) (*[]Engagement, *BatchEngagementsError, *errortools.Error) {
+	ctx, cancel := service.contextWithDefaultTimeout(ctx)
+	defer cancel()
+
 	var engagements []Engagement
+	var batchError BatchEngagementsError
 
 	for _, batch := range service.batches(len(config.Inputs)) {
+		if e := ctx.Err(); e != nil {
+			var partial *BatchEngagementsError
+			if len(batchError.Errors) > 0 {
+				partial = &batchError
+			}
+			return &engagements, partial, errortools.ErrorMessage(fmt.Sprintf("BatchUpdateEngagements cancelled: %s", e))
+		}
+
 		var r BatchEngagementsResponse
 
 		requestConfig := go_http.RequestConfig{
@@ -372,21 +811,21 @@ func (service *Service) BatchUpdateEngagements(config *BatchObjectsConfig) (*[]E
 			ResponseModel: &r,
 		}
 
-		_, response, e := service.httpRequest(&requestConfig)
-		if response != nil {
-			if response.StatusCode == http.StatusMultiStatus {
-				fmt.Println(r.Errors)
-				goto ok
+		_, response, e := service.httpRequestContext(ctx, &requestConfig)
+		if response == nil || response.StatusCode != http.StatusMultiStatus {
+			if e != nil {
+				return nil, nil, e
 			}
+		} else if chunkError := newBatchEngagementsError(r); chunkError != nil {
+			batchError.Errors = append(batchError.Errors, chunkError.Errors...)
 		}
-		if e != nil {
-			return nil, e
-		}
-	ok:
+
 		engagements = append(engagements, r.Results...)
+	}
 
-		fmt.Println("batch", batch.startIndex)
+	if len(batchError.Errors) > 0 {
+		return &engagements, &batchError, nil
 	}
 
-	return &engagements, nil
+	return &engagements, nil, nil
 }
@@ -0,0 +1,92 @@
+package hubspot
+
+import (
+	"context"
+	"fmt"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+)
+
+// EngagementIterator lazily walks a paginated engagements endpoint, fetching
+// the next page only once its buffered page has been drained by Next. Use it
+// instead of ListEngagements/SearchEngagements when a tenant has enough records
+// that buffering the full result set in memory is undesirable.
+type EngagementIterator struct {
+	buffer    []Engagement
+	nextPage  func(ctx context.Context) (*[]Engagement, *Paging, *errortools.Error)
+	exhausted bool
+}
+
+// Next returns the next Engagement, fetching another page from HubSpot when the
+// buffered page has been drained. The bool return is false once iteration is
+// complete; it is then safe to stop calling Next.
+func (it *EngagementIterator) Next(ctx context.Context) (*Engagement, bool, *errortools.Error) {
+	for len(it.buffer) == 0 {
+		if it.exhausted {
+			return nil, false, nil
+		}
+
+		if e := ctx.Err(); e != nil {
+			return nil, false, errortools.ErrorMessage(fmt.Sprintf("iteration cancelled: %s", e))
+		}
+
+		page, paging, e := it.nextPage(ctx)
+		if e != nil {
+			return nil, false, e
+		}
+
+		if page != nil {
+			it.buffer = append(it.buffer, *page...)
+		}
+
+		if paging == nil || paging.Next.After == "" {
+			it.exhausted = true
+		}
+	}
+
+	engagement := it.buffer[0]
+	it.buffer = it.buffer[1:]
+
+	return &engagement, true, nil
+}
+
+// OwnerIterator lazily walks the paginated owners endpoint, fetching the next
+// page only once its buffered page has been drained by Next.
+type OwnerIterator struct {
+	buffer    []Owner
+	nextPage  func(ctx context.Context) (*[]Owner, *Paging, *errortools.Error)
+	exhausted bool
+}
+
+// Next returns the next Owner, fetching another page from HubSpot when the
+// buffered page has been drained. The bool return is false once iteration is
+// complete; it is then safe to stop calling Next.
+func (it *OwnerIterator) Next(ctx context.Context) (*Owner, bool, *errortools.Error) {
+	for len(it.buffer) == 0 {
+		if it.exhausted {
+			return nil, false, nil
+		}
+
+		if e := ctx.Err(); e != nil {
+			return nil, false, errortools.ErrorMessage(fmt.Sprintf("iteration cancelled: %s", e))
+		}
+
+		page, paging, e := it.nextPage(ctx)
+		if e != nil {
+			return nil, false, e
+		}
+
+		if page != nil {
+			it.buffer = append(it.buffer, *page...)
+		}
+
+		if paging == nil || paging.Next.After == "" {
+			it.exhausted = true
+		}
+	}
+
+	owner := it.buffer[0]
+	it.buffer = it.buffer[1:]
+
+	return &owner, true, nil
+}
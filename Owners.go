@@ -1,6 +1,7 @@
 package hubspot
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -43,11 +44,56 @@ type GetOwnersConfig struct {
 // GetOwners returns all owners
 //
 func (service *Service) GetOwners(config *GetOwnersConfig) (*[]Owner, *errortools.Error) {
+	return service.GetOwnersContext(context.Background(), config)
+}
+
+// GetOwnersContext returns all owners, checking ctx between page fetches so a
+// long-running scan can be cancelled or bounded by a deadline. It is implemented
+// on top of IterateOwners.
+//
+func (service *Service) GetOwnersContext(ctx context.Context, config *GetOwnersConfig) (*[]Owner, *errortools.Error) {
+	ctx, cancel := service.contextWithDefaultTimeout(ctx)
+	defer cancel()
+
+	it := service.IterateOwners(ctx, config)
+
+	owners := []Owner{}
+
+	for {
+		owner, ok, e := it.Next(ctx)
+		if e != nil {
+			return nil, e
+		}
+		if !ok {
+			break
+		}
+
+		owners = append(owners, *owner)
+	}
+
+	return &owners, nil
+}
+
+// GetOwnersPages returns a single page of owners together with its Paging, so a
+// caller can persist Paging.Next.After and resume the scan across process restarts.
+func (service *Service) GetOwnersPages(config *GetOwnersConfig) (*[]Owner, *Paging, *errortools.Error) {
+	return service.GetOwnersPagesContext(context.Background(), config)
+}
+
+// GetOwnersPagesContext is the ctx-aware variant of GetOwnersPages.
+func (service *Service) GetOwnersPagesContext(ctx context.Context, config *GetOwnersConfig) (*[]Owner, *Paging, *errortools.Error) {
+	ctx, cancel := service.contextWithDefaultTimeout(ctx)
+	defer cancel()
+
+	return service.getOwnersPage(ctx, config)
+}
+
+// getOwnersPage fetches exactly one page of owners for config, honouring
+// config.After as the cursor to resume from.
+func (service *Service) getOwnersPage(ctx context.Context, config *GetOwnersConfig) (*[]Owner, *Paging, *errortools.Error) {
 	values := url.Values{}
 	endpoint := "owners"
 
-	after := ""
-
 	if config != nil {
 		if config.Limit != nil {
 			values.Set("limit", fmt.Sprintf("%v", *config.Limit))
@@ -56,48 +102,56 @@ func (service *Service) GetOwners(config *GetOwnersConfig) (*[]Owner, *errortool
 			values.Set("email", *config.Email)
 		}
 		if config.After != nil {
-			after = *config.After
+			values.Set("after", *config.After)
 		}
 	}
 
-	owners := []Owner{}
+	ownersResponse := OwnersResponse{}
 
-	for {
-		ownersResponse := OwnersResponse{}
+	requestConfig := go_http.RequestConfig{
+		Method:        http.MethodGet,
+		Url:           service.urlCrm(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
+		ResponseModel: &ownersResponse,
+	}
 
-		if after != "" {
-			values.Set("after", after)
-		}
+	_, _, e := service.httpRequestContext(ctx, &requestConfig)
+	if e != nil {
+		return nil, nil, e
+	}
 
-		requestConfig := go_http.RequestConfig{
-			Method:        http.MethodGet,
-			Url:           service.urlCrm(fmt.Sprintf("%s?%s", endpoint, values.Encode())),
-			ResponseModel: &ownersResponse,
-		}
+	return &ownersResponse.Results, ownersResponse.Paging, nil
+}
 
-		_, _, e := service.httpRequest(&requestConfig)
-		if e != nil {
-			return nil, e
-		}
+// IterateOwners returns an OwnerIterator that lazily fetches pages of owners via
+// Next, only hitting the API again once its buffered page is exhausted. If
+// config.After is set, iteration is limited to that single page, matching the
+// explicit-after behaviour of GetOwners.
+func (service *Service) IterateOwners(ctx context.Context, config *GetOwnersConfig) *OwnerIterator {
+	explicitAfter := config != nil && config.After != nil
+	fetched := false
 
-		owners = append(owners, ownersResponse.Results...)
+	pageConfig := GetOwnersConfig{}
+	if config != nil {
+		pageConfig = *config
+	}
 
-		if config != nil {
-			if config.After != nil { // explicit after parameter requested
-				break
+	return &OwnerIterator{
+		nextPage: func(ctx context.Context) (*[]Owner, *Paging, *errortools.Error) {
+			if explicitAfter && fetched {
+				return nil, nil, nil
 			}
-		}
+			fetched = true
 
-		if ownersResponse.Paging == nil {
-			break
-		}
+			owners, paging, e := service.getOwnersPage(ctx, &pageConfig)
+			if e != nil {
+				return nil, nil, e
+			}
 
-		if ownersResponse.Paging.Next.After == "" {
-			break
-		}
+			if !explicitAfter && paging != nil {
+				pageConfig.After = &paging.Next.After
+			}
 
-		after = ownersResponse.Paging.Next.After
+			return owners, paging, nil
+		},
 	}
-
-	return &owners, nil
 }
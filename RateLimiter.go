@@ -0,0 +1,176 @@
+package hubspot
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	errortools "github.com/leapforce-libraries/go_errortools"
+)
+
+// RateLimiter coordinates how many requests Service may have in flight against
+// HubSpot. Acquire blocks (respecting ctx) until the caller may proceed;
+// Release is called once httpRequestContext stops waiting for the request,
+// i.e. when it has completed or when ctx is done first. Supplying a custom
+// implementation via WithRateLimiter lets multiple processes hitting the same
+// portal share one quota, e.g. a Redis-backed limiter.
+type RateLimiter interface {
+	Acquire(ctx context.Context) *errortools.Error
+	Release()
+}
+
+// WithRateLimiter configures the RateLimiter that httpRequestContext acquires
+// before, and releases after, every request. Passing nil disables rate limiting.
+func (service *Service) WithRateLimiter(rateLimiter RateLimiter) *Service {
+	service.rateLimiter = rateLimiter
+
+	return service
+}
+
+// dailyQuotaWindow is the window a dailyQuota is enforced over.
+const dailyQuotaWindow = 24 * time.Hour
+
+// NewTokenBucketRateLimiter returns the default RateLimiter: a token bucket
+// allowing burst requests at once and refilling at requestsPerSecond. A
+// dailyQuota greater than zero additionally caps the number of requests
+// allowed over a rolling dailyQuotaWindow, approximated with a sliding window
+// counter (the current fixed window plus a weighted share of the previous
+// one) rather than tracked exactly, so memory use stays constant regardless
+// of dailyQuota.
+func NewTokenBucketRateLimiter(requestsPerSecond float64, burst int, dailyQuota int64) RateLimiter {
+	return &tokenBucketRateLimiter{
+		tokens:          float64(burst),
+		maxTokens:       float64(burst),
+		refillPerSecond: requestsPerSecond,
+		lastRefill:      time.Now(),
+		dailyQuota:      dailyQuota,
+		windowStart:     time.Now(),
+	}
+}
+
+type tokenBucketRateLimiter struct {
+	mutex           sync.Mutex
+	tokens          float64
+	maxTokens       float64
+	refillPerSecond float64
+	lastRefill      time.Time
+	dailyQuota      int64
+	windowStart     time.Time
+	windowCount     int64
+	prevWindowCount int64
+}
+
+func (rateLimiter *tokenBucketRateLimiter) Acquire(ctx context.Context) *errortools.Error {
+	for {
+		wait, e := rateLimiter.reserve()
+		if e != nil {
+			return e
+		}
+		if wait <= 0 {
+			return nil
+		}
+
+		if e := sleepContext(ctx, wait); e != nil {
+			return e
+		}
+	}
+}
+
+// reserve takes a token if one is available and the dailyQuota (if any) is not
+// exhausted. If the bucket is empty it returns how long the caller should wait
+// before trying again; if the dailyQuota is exhausted it returns an error.
+func (rateLimiter *tokenBucketRateLimiter) reserve() (time.Duration, *errortools.Error) {
+	rateLimiter.mutex.Lock()
+	defer rateLimiter.mutex.Unlock()
+
+	now := time.Now()
+	elapsedInWindow := rateLimiter.rollWindow(now)
+
+	if rateLimiter.dailyQuota > 0 {
+		weight := float64(dailyQuotaWindow-elapsedInWindow) / float64(dailyQuotaWindow)
+		estimated := float64(rateLimiter.prevWindowCount)*weight + float64(rateLimiter.windowCount)
+		if estimated >= float64(rateLimiter.dailyQuota) {
+			return 0, errortools.ErrorMessage("daily HubSpot request quota exhausted")
+		}
+	}
+
+	rateLimiter.tokens += now.Sub(rateLimiter.lastRefill).Seconds() * rateLimiter.refillPerSecond
+	if rateLimiter.tokens > rateLimiter.maxTokens {
+		rateLimiter.tokens = rateLimiter.maxTokens
+	}
+	rateLimiter.lastRefill = now
+
+	if rateLimiter.tokens < 1 {
+		missing := 1 - rateLimiter.tokens
+		return time.Duration(missing / rateLimiter.refillPerSecond * float64(time.Second)), nil
+	}
+
+	rateLimiter.tokens--
+	rateLimiter.windowCount++
+
+	return 0, nil
+}
+
+// rollWindow advances windowStart/windowCount/prevWindowCount to the
+// dailyQuotaWindow that now falls in, returning how far into that window now is.
+func (rateLimiter *tokenBucketRateLimiter) rollWindow(now time.Time) time.Duration {
+	elapsed := now.Sub(rateLimiter.windowStart)
+	windowsElapsed := int64(elapsed / dailyQuotaWindow)
+
+	if windowsElapsed == 0 {
+		return elapsed
+	}
+
+	if windowsElapsed == 1 {
+		rateLimiter.prevWindowCount = rateLimiter.windowCount
+	} else {
+		rateLimiter.prevWindowCount = 0
+	}
+	rateLimiter.windowCount = 0
+	rateLimiter.windowStart = rateLimiter.windowStart.Add(time.Duration(windowsElapsed) * dailyQuotaWindow)
+
+	return now.Sub(rateLimiter.windowStart)
+}
+
+func (rateLimiter *tokenBucketRateLimiter) Release() {}
+
+// isRetryableResponse reports whether response is a 429 or 5xx that
+// httpRequestContext should retry rather than surface to the caller.
+func isRetryableResponse(response *http.Response) bool {
+	if response == nil {
+		return false
+	}
+
+	return response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= http.StatusInternalServerError
+}
+
+// retryAfter computes how long httpRequestContext should sleep before
+// retrying a rate-limited or transiently failed request. It prefers the
+// Retry-After header HubSpot sends on 429s; failing that, if
+// X-HubSpot-RateLimit-Secondly-Remaining reports the secondly window is
+// exhausted, it waits out X-HubSpot-RateLimit-Interval-Milliseconds (the
+// window's own length) before the caller tries again; otherwise it falls back
+// to a fixed one-second backoff.
+func retryAfter(response *http.Response) time.Duration {
+	if response == nil {
+		return time.Second
+	}
+
+	if v := response.Header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+
+	if response.Header.Get("X-HubSpot-RateLimit-Secondly-Remaining") == "0" {
+		if v := response.Header.Get("X-HubSpot-RateLimit-Interval-Milliseconds"); v != "" {
+			if milliseconds, err := strconv.Atoi(v); err == nil && milliseconds > 0 {
+				return time.Duration(milliseconds) * time.Millisecond
+			}
+		}
+	}
+
+	return time.Second
+}